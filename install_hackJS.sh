@@ -9,7 +9,7 @@ sudo mv hackJS /usr/local/bin/
 echo "Creating directory for wordlist..."
 mkdir -p ~/bin
 
-echo "Moving wordlist to ~/bin/"
-mv WordList.txt ~/bin/
+echo "Copying wordlist to ~/bin/"
+cp WordList.txt ~/bin/
 
 echo "Installation complete."